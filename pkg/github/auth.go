@@ -0,0 +1,296 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// ClientProvider resolves the *github.Client to use for a single tool
+// invocation. Implementations lazily construct (and may cache) the
+// underlying client, so a server can be configured once with a PAT, an
+// OAuth user token, or a GitHub App installation, and let each call pick
+// the identity appropriate to the tool and arguments involved.
+type ClientProvider interface {
+	ClientFor(ctx context.Context, tool string, args map[string]interface{}) (*github.Client, error)
+}
+
+// staticClientProvider always returns the same client, regardless of tool
+// or arguments. This is what a PAT or OAuth user-token deployment uses: a
+// single *github.Client built once at startup.
+type staticClientProvider struct {
+	client *github.Client
+}
+
+// NewStaticClientProvider wraps client as a ClientProvider that always
+// returns it unchanged.
+func NewStaticClientProvider(client *github.Client) ClientProvider {
+	return &staticClientProvider{client: client}
+}
+
+func (p *staticClientProvider) ClientFor(_ context.Context, _ string, _ map[string]interface{}) (*github.Client, error) {
+	return p.client, nil
+}
+
+// installationTokenRefreshSkew is how far ahead of an installation token's
+// reported expiry we mint a replacement, to leave headroom for in-flight
+// requests.
+const installationTokenRefreshSkew = 2 * time.Minute
+
+// AppInstallationClientProvider mints and caches a GitHub App installation
+// token, refreshing it shortly before it expires. appClient must already be
+// authenticated as the app itself, typically by passing NewAppClient's
+// result.
+type AppInstallationClientProvider struct {
+	appClient      *github.Client
+	installationID int64
+
+	mu        sync.Mutex
+	client    *github.Client
+	expiresAt time.Time
+}
+
+// NewAppInstallationClientProvider returns a ClientProvider that serves
+// requests as the given installation of a GitHub App.
+func NewAppInstallationClientProvider(appClient *github.Client, installationID int64) *AppInstallationClientProvider {
+	return &AppInstallationClientProvider{appClient: appClient, installationID: installationID}
+}
+
+func (p *AppInstallationClientProvider) ClientFor(ctx context.Context, _ string, _ map[string]interface{}) (*github.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.client != nil && time.Now().Before(p.expiresAt.Add(-installationTokenRefreshSkew)) {
+		return p.client, nil
+	}
+
+	token, resp, err := p.appClient.Apps.CreateInstallationToken(ctx, p.installationID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create installation token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	p.client = deriveAuthenticatedClient(p.appClient, token.GetToken())
+	p.expiresAt = token.GetExpiresAt().Time
+
+	return p.client, nil
+}
+
+// deriveAuthenticatedClient builds a client authenticated with token,
+// inheriting base's BaseURL, UploadURL, and underlying *http.Client (and
+// therefore its transport: proxy, retries, etc.) rather than falling back to
+// api.github.com defaults. This matters for GitHub Enterprise deployments,
+// where base is configured against the enterprise host via
+// WithEnterpriseURLs.
+func deriveAuthenticatedClient(base *github.Client, token string) *github.Client {
+	client := github.NewClient(base.Client()).WithAuthToken(token)
+	client.BaseURL = base.BaseURL
+	client.UploadURL = base.UploadURL
+	return client
+}
+
+// appJWTLifetime is how long each minted app JWT is valid for. GitHub caps
+// this at 10 minutes; we stay under it to leave margin for clock drift.
+const appJWTLifetime = 9 * time.Minute
+
+// appJWTClockDriftSkew backdates a JWT's issued-at time, as GitHub's own
+// docs recommend, to tolerate a small amount of clock drift between this
+// host and GitHub's.
+const appJWTClockDriftSkew = 30 * time.Second
+
+// NewAppClient builds a *github.Client authenticated as a GitHub App itself
+// (not any particular installation), by attaching a short-lived RS256 JWT,
+// signed from appID and the app's PEM-encoded private key (PKCS#1 or PKCS#8),
+// to each request. The returned client is what NewAppInstallationClientProvider
+// expects as appClient; it is not useful for resource-scoped calls on its
+// own, only for minting installation tokens.
+//
+// base, if non-nil, supplies the *http.Client whose transport and timeout
+// the app's JWT signing wraps rather than replaces, so a deployment's
+// proxy/retry/GHE configuration carries through to app-level calls too.
+func NewAppClient(appID int64, privateKeyPEM []byte, base *http.Client) (*github.Client, error) {
+	key, err := parseRSAPrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GitHub App private key: %w", err)
+	}
+
+	var (
+		transport http.RoundTripper
+		timeout   time.Duration
+	)
+	if base != nil {
+		transport = base.Transport
+		timeout = base.Timeout
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	httpClient := &http.Client{
+		Transport: &appJWTTransport{appID: appID, key: key, base: transport},
+		Timeout:   timeout,
+	}
+
+	return github.NewClient(httpClient), nil
+}
+
+// parseRSAPrivateKey decodes a PEM block holding a GitHub App private key,
+// accepting either PKCS#1 ("RSA PRIVATE KEY") or PKCS#8 ("PRIVATE KEY")
+// encoding, both of which GitHub's app settings page offers on download.
+func parseRSAPrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// appJWTTransport attaches an app-authentication JWT to each request,
+// signing a fresh one only when the cached one is within
+// installationTokenRefreshSkew of expiry, and delegates the round trip
+// itself to base.
+type appJWTTransport struct {
+	appID int64
+	key   *rsa.PrivateKey
+	base  http.RoundTripper
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func (t *appJWTTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.jwt()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(req)
+}
+
+func (t *appJWTTransport) jwt() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && time.Now().Before(t.expiresAt.Add(-installationTokenRefreshSkew)) {
+		return t.token, nil
+	}
+
+	now := time.Now()
+	issuedAt := now.Add(-appJWTClockDriftSkew)
+	expiresAt := now.Add(appJWTLifetime)
+
+	token, err := signAppJWT(t.appID, issuedAt, expiresAt, t.key)
+	if err != nil {
+		return "", err
+	}
+
+	t.token = token
+	t.expiresAt = expiresAt
+	return t.token, nil
+}
+
+// signAppJWT builds and RS256-signs the minimal JWT GitHub's app
+// authentication expects: a header naming the algorithm, and claims
+// asserting issuer (the app ID), issuedAt, and expiresAt.
+func signAppJWT(appID int64, issuedAt, expiresAt time.Time, key *rsa.PrivateKey) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT header: %w", err)
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": issuedAt.Unix(),
+		"exp": expiresAt.Unix(),
+		"iss": strconv.FormatInt(appID, 10),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT claims: %w", err)
+	}
+
+	signingInput := base64URLEncode(header) + "." + base64URLEncode(claims)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return signingInput + "." + base64URLEncode(signature), nil
+}
+
+func base64URLEncode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// IdentityClientProvider chooses, per tool call, between a single client
+// acting as the authenticated user and a set of per-organization GitHub App
+// installation clients. Tools named in userOnlyTools (e.g. get_me) always
+// use the user client; everything else uses the installation client for the
+// org named by the call's "owner" argument (or, when "owner" is absent or
+// empty, its "org" argument), falling back to the user client when no
+// installation is configured for that org.
+type IdentityClientProvider struct {
+	userClient    *github.Client
+	installations map[string]ClientProvider
+	userOnlyTools map[string]struct{}
+}
+
+// NewIdentityClientProvider builds an IdentityClientProvider. installations
+// maps an organization login to the ClientProvider that mints tokens for
+// the app's installation on that org.
+func NewIdentityClientProvider(userClient *github.Client, installations map[string]ClientProvider, userOnlyTools ...string) *IdentityClientProvider {
+	only := make(map[string]struct{}, len(userOnlyTools))
+	for _, name := range userOnlyTools {
+		only[name] = struct{}{}
+	}
+	return &IdentityClientProvider{
+		userClient:    userClient,
+		installations: installations,
+		userOnlyTools: only,
+	}
+}
+
+func (p *IdentityClientProvider) ClientFor(ctx context.Context, tool string, args map[string]interface{}) (*github.Client, error) {
+	if _, ok := p.userOnlyTools[tool]; ok {
+		return p.userClient, nil
+	}
+
+	owner, _ := args["owner"].(string)
+	if owner == "" {
+		owner, _ = args["org"].(string)
+	}
+
+	provider, ok := p.installations[owner]
+	if !ok {
+		return p.userClient, nil
+	}
+
+	return provider.ClientFor(ctx, tool, args)
+}
@@ -0,0 +1,193 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StaticClientProvider(t *testing.T) {
+	client := github.NewClient(nil)
+	provider := NewStaticClientProvider(client)
+
+	got, err := provider.ClientFor(context.Background(), "get_me", nil)
+	require.NoError(t, err)
+	assert.Same(t, client, got)
+}
+
+func Test_AppInstallationClientProvider(t *testing.T) {
+	mintCount := 0
+
+	tests := []struct {
+		name          string
+		expiresAt     time.Time
+		wantMintCalls int
+	}{
+		{
+			name:          "mints once and reuses a token that is far from expiry",
+			expiresAt:     time.Now().Add(time.Hour),
+			wantMintCalls: 1,
+		},
+		{
+			name:          "re-mints a token that is within the refresh skew",
+			expiresAt:     time.Now().Add(installationTokenRefreshSkew / 2),
+			wantMintCalls: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mintCount = 0
+			mockedClient := mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostAppInstallationsAccessTokensByInstallationId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						mintCount++
+						token := &github.InstallationToken{
+							Token:     github.Ptr("installation-token"),
+							ExpiresAt: &github.Timestamp{Time: tc.expiresAt},
+						}
+						w.Header().Set("Content-Type", "application/json")
+						require.NoError(t, json.NewEncoder(w).Encode(token))
+					}),
+				),
+			)
+
+			appClient := github.NewClient(mockedClient)
+			provider := NewAppInstallationClientProvider(appClient, 42)
+
+			_, err := provider.ClientFor(context.Background(), "get_repository", nil)
+			require.NoError(t, err)
+			_, err = provider.ClientFor(context.Background(), "get_repository", nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantMintCalls, mintCount)
+		})
+	}
+}
+
+func Test_AppInstallationClientProvider_PreservesBaseClientConfig(t *testing.T) {
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostAppInstallationsAccessTokensByInstallationId,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				token := &github.InstallationToken{
+					Token:     github.Ptr("installation-token"),
+					ExpiresAt: &github.Timestamp{Time: time.Now().Add(time.Hour)},
+				}
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(token))
+			}),
+		),
+	)
+
+	appClient := github.NewClient(mockedClient)
+	appClient.BaseURL = &url.URL{Scheme: "https", Host: "ghe.example.com", Path: "/api/v3/"}
+	appClient.UploadURL = &url.URL{Scheme: "https", Host: "ghe.example.com", Path: "/api/uploads/"}
+
+	provider := NewAppInstallationClientProvider(appClient, 42)
+
+	client, err := provider.ClientFor(context.Background(), "get_repository", nil)
+	require.NoError(t, err)
+	assert.Equal(t, appClient.BaseURL, client.BaseURL)
+	assert.Equal(t, appClient.UploadURL, client.UploadURL)
+}
+
+func Test_NewAppClient(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pemBytes := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	var gotAuth string
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetApp,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAuth = r.Header.Get("Authorization")
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(&github.App{}))
+			}),
+		),
+	)
+
+	client, err := NewAppClient(12345, pemBytes, mockedClient)
+	require.NoError(t, err)
+
+	_, _, err = client.Apps.Get(context.Background(), "")
+	require.NoError(t, err)
+
+	assert.Regexp(t, `^Bearer [\w-]+\.[\w-]+\.[\w-]+$`, gotAuth)
+}
+
+func Test_NewAppClient_InvalidKey(t *testing.T) {
+	_, err := NewAppClient(12345, []byte("not a pem"), nil)
+	require.Error(t, err)
+}
+
+func Test_IdentityClientProvider(t *testing.T) {
+	userClient := github.NewClient(nil)
+	orgClient := github.NewClient(nil)
+
+	provider := NewIdentityClientProvider(
+		userClient,
+		map[string]ClientProvider{
+			"my-org": NewStaticClientProvider(orgClient),
+		},
+		"get_me",
+	)
+
+	tests := []struct {
+		name string
+		tool string
+		args map[string]interface{}
+		want *github.Client
+	}{
+		{
+			name: "user-only tool always uses the user client",
+			tool: "get_me",
+			args: map[string]interface{}{"owner": "my-org"},
+			want: userClient,
+		},
+		{
+			name: "resource-scoped tool with a configured installation uses it",
+			tool: "list_issues",
+			args: map[string]interface{}{"owner": "my-org"},
+			want: orgClient,
+		},
+		{
+			name: "resource-scoped tool with no installation falls back to the user client",
+			tool: "list_issues",
+			args: map[string]interface{}{"owner": "unconfigured-org"},
+			want: userClient,
+		},
+		{
+			name: "no owner argument falls back to the user client",
+			tool: "list_issues",
+			args: map[string]interface{}{},
+			want: userClient,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := provider.ClientFor(context.Background(), tc.tool, tc.args)
+			require.NoError(t, err)
+			assert.Same(t, tc.want, got)
+		})
+	}
+}
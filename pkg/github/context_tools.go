@@ -0,0 +1,52 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// getMe creates a tool to get details of the authenticated user. It asks
+// clients for a client under the tool name "get_me"; which identity that is
+// depends on the provider. An IdentityClientProvider that lists get_me as a
+// user-only tool always resolves it to the acting user's own identity.
+func getMe(clients ClientProvider, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_me",
+		mcp.WithDescription(t("TOOL_GET_ME_DESCRIPTION", "Get details of the authenticated GitHub user")),
+		mcp.WithString("reason",
+			mcp.Description("Optional: the reason for checking the user's details"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := clients.ClientFor(ctx, "get_me", request.GetArguments())
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve client: %w", err)
+		}
+
+		user, resp, err := client.Users.Get(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != 200 {
+			body, _ := io.ReadAll(resp.Body)
+			return nil, fmt.Errorf("failed to get user: %s", string(body))
+		}
+
+		r, err := json.Marshal(user)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal user: %w", err)
+		}
+
+		return mcp.NewToolResultText(string(r)), nil
+	}
+
+	return tool, withValidation(tool, handler)
+}
@@ -0,0 +1,19 @@
+package github
+
+import (
+	"errors"
+
+	gogithub "github.com/google/go-github/v69/github"
+)
+
+// isAcceptedError reports whether err is (or wraps) a github.AcceptedError,
+// which the GitHub API returns when a request has been queued for
+// asynchronous processing rather than served inline.
+func isAcceptedError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var acceptedErr *gogithub.AcceptedError
+	return errors.As(err, &acceptedErr)
+}
@@ -0,0 +1,310 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// eventFilters mirrors Docker's filters.Args: a set of named dimensions
+// (e.g. "type", "actor", "repo"), each carrying a list of accepted values.
+// streamEvents keeps an event only if, for each of the "type", "actor" and
+// "repo" dimensions that is populated, one of its values matches; values
+// under any other dimension are ignored.
+type eventFilters map[string][]string
+
+// matches reports whether value satisfies the filter for dimension. A
+// dimension with no configured values imposes no restriction.
+func (f eventFilters) matches(dimension, value string) bool {
+	allowed, ok := f[dimension]
+	if !ok || len(allowed) == 0 {
+		return true
+	}
+	for _, v := range allowed {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// optionalEventFiltersParam extracts the optional "filters" object
+// parameter, converting each of its entries to a []string. Each entry must
+// be a []interface{} (as produced by JSON decoding) whose elements are all
+// strings; unlike optionalStringArrayParam, a []string entry is rejected.
+func optionalEventFiltersParam(r mcp.CallToolRequest, p string) (eventFilters, error) {
+	args := r.GetArguments()
+
+	raw, ok := args[p]
+	if !ok {
+		return eventFilters{}, nil
+	}
+
+	rawMap, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parameter %s is not of type object, is %T", p, raw)
+	}
+
+	filters := make(eventFilters, len(rawMap))
+	for dimension, values := range rawMap {
+		items, ok := values.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("parameter %s.%s is not of type []string, is %T", p, dimension, values)
+		}
+		strs := make([]string, 0, len(items))
+		for _, item := range items {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("parameter %s.%s contains a non-string element: %v", p, dimension, item)
+			}
+			strs = append(strs, s)
+		}
+		filters[dimension] = strs
+	}
+
+	return filters, nil
+}
+
+// normalizedEvent is the shape returned to MCP clients for every event
+// source streamEvents knows how to read, regardless of which underlying
+// GitHub API produced it.
+type normalizedEvent struct {
+	Type      string    `json:"type"`
+	Actor     string    `json:"actor,omitempty"`
+	Repo      string    `json:"repo,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func normalizeActivityEvent(e *github.Event) normalizedEvent {
+	n := normalizedEvent{
+		Type:      e.GetType(),
+		Actor:     e.GetActor().GetLogin(),
+		Repo:      e.GetRepo().GetName(),
+		CreatedAt: e.GetCreatedAt().Time,
+	}
+	return n
+}
+
+func normalizeAuditEntry(e *github.AuditEntry) normalizedEvent {
+	return normalizedEvent{
+		Type:      e.GetAction(),
+		Actor:     e.GetActor(),
+		Repo:      e.GetOrg(),
+		CreatedAt: e.GetTimestamp().Time,
+	}
+}
+
+// inWindow reports whether t falls within [since, until]. A zero since or
+// until leaves that side of the window unbounded.
+func inWindow(t, since, until time.Time) bool {
+	if !since.IsZero() && t.Before(since) {
+		return false
+	}
+	if !until.IsZero() && t.After(until) {
+		return false
+	}
+	return true
+}
+
+// streamEvents creates a tool that retrieves GitHub events for a user, a
+// repository, or an organization's audit log, narrowed by a since/until
+// time window and a set of event filters. Repository- and org-scoped calls
+// resolve their client through clients using the "owner"/"org" argument, so
+// a server configured with per-org GitHub App installations reads each
+// repo's events with that repo's own installation token.
+//
+// Pagination differs by scope: "user" and "repo" are page-numbered
+// (page/perPage), while the "org" audit log is cursor-paginated
+// (after/before/perPage) per GitHub's own API.
+func streamEvents(clients ClientProvider, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("stream_events",
+		mcp.WithDescription(t("TOOL_STREAM_EVENTS_DESCRIPTION", "Stream GitHub events for a user, repository, or organization audit log, within a time window and matching optional filters")),
+		mcp.WithString("scope",
+			mcp.Required(),
+			mcp.Description("Which event source to read: 'user', 'repo', or 'org'"),
+		),
+		mcp.WithString("user",
+			mcp.Description("Username whose events to list. Required when scope is 'user'"),
+		),
+		mcp.WithString("owner",
+			mcp.Description("Repository owner. Required when scope is 'repo'"),
+		),
+		mcp.WithString("repo",
+			mcp.Description("Repository name. Required when scope is 'repo'"),
+		),
+		mcp.WithString("org",
+			mcp.Description("Organization login whose audit log to read. Required when scope is 'org'"),
+		),
+		mcp.WithString("since",
+			mcp.Description("Only return events at or after this time (RFC3339 or unix epoch)"),
+		),
+		mcp.WithString("until",
+			mcp.Description("Only return events at or before this time (RFC3339 or unix epoch)"),
+		),
+		mcp.WithObject("filters",
+			mcp.Description("Map of dimension to the list of values an event must match on that dimension"),
+			mcp.Properties(map[string]interface{}{
+				"type": map[string]interface{}{
+					"type":        "array",
+					"description": "Event types to keep (e.g. 'PushEvent', 'IssuesEvent')",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+				"actor": map[string]interface{}{
+					"type":        "array",
+					"description": "Actor logins to keep",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+				"repo": map[string]interface{}{
+					"type":        "array",
+					"description": "Repository names to keep",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+			}),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Page number of the results to fetch. Applies to scope 'user' and 'repo' only; the 'org' audit log is cursor-paginated, see 'after'/'before'"),
+		),
+		mcp.WithNumber("perPage",
+			mcp.Description("Results per page"),
+		),
+		mcp.WithString("after",
+			mcp.Description("Cursor to fetch the page after. Applies to scope 'org' only"),
+		),
+		mcp.WithString("before",
+			mcp.Description("Cursor to fetch the page before. Applies to scope 'org' only"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		scope, err := requiredParam[string](request, "scope")
+		if err != nil {
+			return nil, err
+		}
+
+		since, err := optionalTimeParam(request, "since")
+		if err != nil {
+			return nil, err
+		}
+		until, err := optionalTimeParam(request, "until")
+		if err != nil {
+			return nil, err
+		}
+
+		filters, err := optionalEventFiltersParam(request, "filters")
+		if err != nil {
+			return nil, err
+		}
+
+		pagination, err := optionalPaginationParams(request)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := clients.ClientFor(ctx, "stream_events", request.GetArguments())
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve client: %w", err)
+		}
+
+		var events []normalizedEvent
+
+		switch scope {
+		case "user":
+			user, err := requiredParam[string](request, "user")
+			if err != nil {
+				return nil, err
+			}
+			raw, resp, err := client.Activity.ListEventsPerformedByUser(ctx, user, false, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list events for user: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+			for _, e := range raw {
+				events = append(events, normalizeActivityEvent(e))
+			}
+		case "repo":
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return nil, err
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return nil, err
+			}
+			raw, resp, err := client.Activity.ListRepositoryEvents(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list events for repo: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+			for _, e := range raw {
+				events = append(events, normalizeActivityEvent(e))
+			}
+		case "org":
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return nil, err
+			}
+			after, err := optionalParam[string](request, "after")
+			if err != nil {
+				return nil, err
+			}
+			before, err := optionalParam[string](request, "before")
+			if err != nil {
+				return nil, err
+			}
+			raw, resp, err := client.Organizations.GetAuditLog(ctx, org, &github.GetAuditLogOptions{
+				ListCursorOptions: github.ListCursorOptions{
+					PerPage: pagination.perPage,
+					After:   after,
+					Before:  before,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get org audit log: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+			for _, e := range raw {
+				events = append(events, normalizeAuditEntry(e))
+			}
+		default:
+			return nil, fmt.Errorf("unknown scope: %s, must be one of 'user', 'repo', 'org'", scope)
+		}
+
+		filtered := make([]normalizedEvent, 0, len(events))
+		for _, e := range events {
+			if !inWindow(e.CreatedAt, since, until) {
+				continue
+			}
+			if !filters.matches("type", e.Type) {
+				continue
+			}
+			if !filters.matches("actor", e.Actor) {
+				continue
+			}
+			if !filters.matches("repo", e.Repo) {
+				continue
+			}
+			filtered = append(filtered, e)
+		}
+
+		r, err := json.Marshal(filtered)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal events: %w", err)
+		}
+
+		return mcp.NewToolResultText(string(r)), nil
+	}
+
+	return tool, withValidation(tool, handler)
+}
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StreamEvents(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := streamEvents(NewStaticClientProvider(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "stream_events", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "filters")
+	assert.Contains(t, tool.InputSchema.Required, "scope")
+
+	now := time.Now()
+	mockEvents := []*github.Event{
+		{
+			Type:      github.Ptr("PushEvent"),
+			Actor:     &github.User{Login: github.Ptr("octocat")},
+			Repo:      &github.Repository{Name: github.Ptr("hello-world")},
+			CreatedAt: &github.Timestamp{Time: now},
+		},
+		{
+			Type:      github.Ptr("IssuesEvent"),
+			Actor:     &github.User{Login: github.Ptr("octocat")},
+			Repo:      &github.Repository{Name: github.Ptr("hello-world")},
+			CreatedAt: &github.Timestamp{Time: now.Add(-48 * time.Hour)},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		requestArgs   map[string]interface{}
+		expectError   string
+		expectedCount int
+	}{
+		{
+			name: "filters by type and time window",
+			requestArgs: map[string]interface{}{
+				"scope": "user",
+				"user":  "octocat",
+				"since": now.Add(-time.Hour).Format(time.RFC3339),
+				"filters": map[string]interface{}{
+					"type": []interface{}{"PushEvent"},
+				},
+			},
+			expectedCount: 1,
+		},
+		{
+			name: "unknown scope",
+			requestArgs: map[string]interface{}{
+				"scope": "nonsense",
+			},
+			expectError: "unknown scope",
+		},
+		{
+			name: "missing user for user scope",
+			requestArgs: map[string]interface{}{
+				"scope": "user",
+			},
+			expectError: "missing required parameter: user",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersEventsByUsername,
+					mockEvents,
+				),
+			)
+			client := github.NewClient(mockedClient)
+			_, handler := streamEvents(NewStaticClientProvider(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var got []normalizedEvent
+			err = json.Unmarshal([]byte(textContent.Text), &got)
+			require.NoError(t, err)
+			assert.Len(t, got, tc.expectedCount)
+		})
+	}
+}
+
+func Test_StreamEvents_OrgScope(t *testing.T) {
+	now := time.Now()
+	mockEntries := []*github.AuditEntry{
+		{
+			Action:    github.Ptr("repo.create"),
+			Actor:     github.Ptr("octocat"),
+			Org:       github.Ptr("my-org"),
+			Timestamp: &github.Timestamp{Time: now},
+		},
+	}
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetOrgsAuditLogByOrg,
+			mockEntries,
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := streamEvents(NewStaticClientProvider(client), translations.NullTranslationHelper)
+
+	request := createMCPRequest(map[string]interface{}{
+		"scope": "org",
+		"org":   "my-org",
+		"after": "cursor-token",
+	})
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var got []normalizedEvent
+	err = json.Unmarshal([]byte(textContent.Text), &got)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "repo.create", got[0].Type)
+	assert.Equal(t, "my-org", got[0].Repo)
+}
+
+func Test_StreamEvents_FiltersSchemaValidation(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	_, handler := streamEvents(NewStaticClientProvider(mockClient), translations.NullTranslationHelper)
+
+	_, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"scope": "user",
+		"user":  "octocat",
+		"filters": map[string]interface{}{
+			"type": []interface{}{"PushEvent", 5},
+		},
+	}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "filters.type[1]")
+}
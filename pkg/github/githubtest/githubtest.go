@@ -0,0 +1,240 @@
+// Package githubtest provides a reusable mock harness and fixture builders
+// for exercising pkg/github's MCP tools without hand-rolling
+// go-github-mock wiring in every test file.
+package githubtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Route describes a single endpoint a Server should respond to.
+type Route struct {
+	// Pattern identifies the GitHub REST endpoint, e.g. mock.GetUser.
+	Pattern mock.EndpointPattern
+	// Response is JSON-encoded as a 200 response body. Ignored if Handler
+	// is set.
+	Response interface{}
+	// Handler, when set, takes full control of the response (status code,
+	// headers, malformed bodies, ...).
+	Handler http.HandlerFunc
+	// WantMethod, WantQuery and WantBody assert on the incoming request
+	// before it is answered. A mismatch is reported as a test failure with a
+	// diff rather than silently producing a confusing downstream failure;
+	// the request is still answered afterwards.
+	WantMethod string
+	WantQuery  url.Values
+	WantBody   interface{}
+}
+
+// Server stacks Routes into an *http.Client suitable for github.NewClient.
+type Server struct {
+	t      *testing.T
+	routes []Route
+}
+
+// NewServer builds a Server that answers every request matching a route's
+// Pattern with that route's response, as many times as it is requested.
+func NewServer(t *testing.T, routes ...Route) *Server {
+	t.Helper()
+	return &Server{t: t, routes: routes}
+}
+
+// Client returns an *http.Client wired to replay s's routes via
+// go-github-mock.
+func (s *Server) Client() *http.Client {
+	options := make([]mock.MockBackendOption, 0, len(s.routes))
+	for _, route := range s.routes {
+		handler := route.Handler
+		if handler == nil {
+			handler = s.jsonHandler(route)
+		}
+		options = append(options, mock.WithRequestMatchHandler(route.Pattern, s.asserting(route, handler)))
+	}
+	return mock.NewMockedHTTPClient(options...)
+}
+
+func (s *Server) jsonHandler(route Route) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		require.NoError(s.t, json.NewEncoder(w).Encode(route.Response))
+	}
+}
+
+func (s *Server) asserting(route Route, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.t.Helper()
+
+		if route.WantMethod != "" {
+			assert.Equal(s.t, route.WantMethod, r.Method, "unexpected HTTP method for %v", route.Pattern)
+		}
+		if route.WantQuery != nil {
+			assert.Equal(s.t, route.WantQuery, r.URL.Query(), "unexpected query for %v", route.Pattern)
+		}
+		if route.WantBody != nil {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(s.t, err)
+			var got interface{}
+			require.NoError(s.t, json.Unmarshal(body, &got))
+			assert.Equal(s.t, route.WantBody, got, "unexpected body for %v", route.Pattern)
+		}
+
+		next(w, r)
+	}
+}
+
+// RecordedRoute replays a saved HTTP transcript so contributors can add
+// integration-style tests against real GitHub responses without hitting the
+// network.
+type RecordedRoute struct {
+	Pattern mock.EndpointPattern
+	// File points to a JSON transcript: {"status": 200, "body": {...}}.
+	// A missing "status" defaults to 200.
+	File string
+}
+
+// Load reads rr.File and turns it into a Route ready for NewServer.
+func (rr RecordedRoute) Load(t *testing.T) Route {
+	t.Helper()
+
+	data, err := os.ReadFile(rr.File)
+	require.NoError(t, err, "reading recorded transcript %s", rr.File)
+
+	var transcript struct {
+		Status int             `json:"status"`
+		Body   json.RawMessage `json:"body"`
+	}
+	require.NoError(t, json.Unmarshal(data, &transcript))
+
+	status := transcript.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	return Route{
+		Pattern: rr.Pattern,
+		Handler: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write(transcript.Body)
+		},
+	}
+}
+
+// FixtureUser returns a github.User populated with reasonable defaults;
+// opts are applied in order to override individual fields.
+func FixtureUser(opts ...func(*github.User)) *github.User {
+	u := &github.User{
+		Login:   github.Ptr("octocat"),
+		Name:    github.Ptr("Mona Octocat"),
+		Email:   github.Ptr("octocat@github.com"),
+		HTMLURL: github.Ptr("https://github.com/octocat"),
+		Type:    github.Ptr("User"),
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// FixtureRelease returns a github.RepositoryRelease populated with
+// reasonable defaults; opts are applied in order to override individual
+// fields.
+func FixtureRelease(opts ...func(*github.RepositoryRelease)) *github.RepositoryRelease {
+	r := &github.RepositoryRelease{
+		TagName: github.Ptr("v1.0.0"),
+		Name:    github.Ptr("v1.0.0"),
+		HTMLURL: github.Ptr("https://github.com/octocat/hello-world/releases/tag/v1.0.0"),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// FixtureRepo returns a github.Repository populated with reasonable
+// defaults; opts are applied in order to override individual fields.
+func FixtureRepo(opts ...func(*github.Repository)) *github.Repository {
+	repo := &github.Repository{
+		Name:     github.Ptr("hello-world"),
+		FullName: github.Ptr("octocat/hello-world"),
+		Owner:    FixtureUser(),
+	}
+	for _, opt := range opts {
+		opt(repo)
+	}
+	return repo
+}
+
+// CreateMCPRequest builds a mcp.CallToolRequest carrying args as its
+// arguments, for use by tool handler tests.
+func CreateMCPRequest(args map[string]interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: args,
+		},
+	}
+}
+
+// MockResponse returns an http.HandlerFunc that writes body as JSON with
+// the given status code, for use as a Route.Handler or directly with
+// mock.WithRequestMatchHandler.
+func MockResponse(t *testing.T, status int, body interface{}) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		require.NoError(t, json.NewEncoder(w).Encode(body))
+	}
+}
+
+// TextResult extracts the single mcp.TextContent from a tool result,
+// failing the test if the result is nil or not text.
+func TextResult(t *testing.T, result *mcp.CallToolResult) mcp.TextContent {
+	t.Helper()
+	require.NotNil(t, result)
+	require.NotEmpty(t, result.Content)
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok, "expected text content, got %T", result.Content[0])
+	return textContent
+}
+
+// ToolHandler matches mcp-go's server.ToolHandlerFunc without importing the
+// server package, so githubtest doesn't need to know about tool
+// construction, only invocation.
+type ToolHandler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)
+
+// RunToolTest invokes handler with args and asserts that its result
+// unmarshals to the same JSON as wantJSON. Comparing through JSON (rather
+// than requiring wantJSON to be the exact Go type the handler returns)
+// keeps call sites to a single line for the common "does this tool return
+// what I expect" case.
+func RunToolTest(t *testing.T, handler ToolHandler, args map[string]interface{}, wantJSON interface{}) {
+	t.Helper()
+
+	result, err := handler(context.Background(), CreateMCPRequest(args))
+	require.NoError(t, err)
+	textContent := TextResult(t, result)
+
+	var got interface{}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+
+	wantBytes, err := json.Marshal(wantJSON)
+	require.NoError(t, err)
+	var want interface{}
+	require.NoError(t, json.Unmarshal(wantBytes, &want))
+
+	assert.Equal(t, want, got)
+}
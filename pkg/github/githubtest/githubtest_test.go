@@ -0,0 +1,36 @@
+package githubtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RecordedRoute_Load(t *testing.T) {
+	rr := RecordedRoute{Pattern: mock.GetUser, File: "testdata/get_user.json"}
+	route := rr.Load(t)
+
+	server := NewServer(t, route)
+	client := github.NewClient(server.Client())
+
+	user, _, err := client.Users.Get(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "octocat", user.GetLogin())
+	assert.Equal(t, "Mona Octocat", user.GetName())
+}
+
+func Test_FixtureRepo(t *testing.T) {
+	repo := FixtureRepo()
+	assert.Equal(t, "hello-world", repo.GetName())
+	assert.Equal(t, "octocat/hello-world", repo.GetFullName())
+	assert.Equal(t, "octocat", repo.GetOwner().GetLogin())
+
+	custom := FixtureRepo(func(r *github.Repository) {
+		r.Name = github.Ptr("other-repo")
+	})
+	assert.Equal(t, "other-repo", custom.GetName())
+}
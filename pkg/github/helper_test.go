@@ -0,0 +1,25 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/github/githubtest"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// createMCPRequest, mockResponse and getTextResult are thin aliases over
+// githubtest so existing call sites in this package's tests don't need an
+// import change; see pkg/github/githubtest for the shared implementation.
+
+func createMCPRequest(args map[string]interface{}) mcp.CallToolRequest {
+	return githubtest.CreateMCPRequest(args)
+}
+
+func mockResponse(t *testing.T, status int, body interface{}) http.HandlerFunc {
+	return githubtest.MockResponse(t, status, body)
+}
+
+func getTextResult(t *testing.T, result *mcp.CallToolResult) mcp.TextContent {
+	return githubtest.TextResult(t, result)
+}
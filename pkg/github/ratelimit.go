@@ -0,0 +1,45 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// rateLimit creates a tool that reports the remaining GitHub API quota for
+// whichever identity (user token or app installation) resolves for the
+// call's arguments.
+func rateLimit(clients ClientProvider, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("ratelimit",
+		mcp.WithDescription(t("TOOL_RATELIMIT_DESCRIPTION", "Report the remaining GitHub API rate limit for the acting identity")),
+		mcp.WithString("owner",
+			mcp.Description("Optional: organization login, to check the quota of that org's app installation rather than the acting user"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := clients.ClientFor(ctx, "ratelimit", request.GetArguments())
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve client: %w", err)
+		}
+
+		limits, resp, err := client.RateLimits(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rate limit: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		r, err := json.Marshal(limits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal rate limit: %w", err)
+		}
+
+		return mcp.NewToolResultText(string(r)), nil
+	}
+
+	return tool, withValidation(tool, handler)
+}
@@ -0,0 +1,33 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/github/githubtest"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RateLimit(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := rateLimit(NewStaticClientProvider(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "ratelimit", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+
+	mockLimits := &github.RateLimits{
+		Core: &github.Rate{Limit: 5000, Remaining: 4999},
+	}
+
+	server := githubtest.NewServer(t, githubtest.Route{Pattern: mock.GetRateLimit, Response: mockLimits})
+	client := github.NewClient(server.Client())
+	_, handler := rateLimit(NewStaticClientProvider(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	getTextResult(t, result)
+}
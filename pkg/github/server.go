@@ -0,0 +1,178 @@
+// Package github implements the MCP tools that expose the GitHub API to
+// MCP clients: one unexported constructor per tool, each returning the
+// mcp.Tool definition alongside its server.ToolHandlerFunc.
+package github
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// paginationParams holds the common page/perPage pair accepted by list-style
+// tools.
+type paginationParams struct {
+	page    int
+	perPage int
+}
+
+// requiredParam is a type-safe helper for extracting a required parameter
+// from a mcp.CallToolRequest. When a tool is registered through
+// withValidation, request.GetArguments() will already have passed schema
+// validation by the time a handler calls this, so the checks below are a
+// backstop rather than the primary line of defense.
+// It returns an error if the parameter is missing, is the wrong type, or
+// is the zero value for T.
+func requiredParam[T comparable](r mcp.CallToolRequest, p string) (T, error) {
+	var zero T
+
+	args := r.GetArguments()
+
+	if _, ok := args[p]; !ok {
+		return zero, fmt.Errorf("missing required parameter: %s", p)
+	}
+
+	val, ok := args[p].(T)
+	if !ok {
+		return zero, fmt.Errorf("parameter %s is not of type %T, is %T", p, zero, args[p])
+	}
+
+	if val == zero {
+		return zero, fmt.Errorf("missing required parameter: %s", p)
+	}
+
+	return val, nil
+}
+
+// optionalParam is a type-safe helper for extracting an optional parameter
+// from a mcp.CallToolRequest. It returns the zero value of T if the
+// parameter is absent, and an error only if the parameter is present but of
+// the wrong type.
+func optionalParam[T any](r mcp.CallToolRequest, p string) (T, error) {
+	var zero T
+
+	args := r.GetArguments()
+
+	if _, ok := args[p]; !ok {
+		return zero, nil
+	}
+
+	val, ok := args[p].(T)
+	if !ok {
+		return zero, fmt.Errorf("parameter %s is not of type %T, is %T", p, zero, args[p])
+	}
+
+	return val, nil
+}
+
+// requiredInt extracts a required numeric parameter, converting it from the
+// float64 that JSON decoding produces to an int.
+func requiredInt(r mcp.CallToolRequest, p string) (int, error) {
+	v, err := requiredParam[float64](r, p)
+	if err != nil {
+		return 0, err
+	}
+	return int(v), nil
+}
+
+// optionalIntParam extracts an optional numeric parameter as an int,
+// defaulting to 0 when absent.
+func optionalIntParam(r mcp.CallToolRequest, p string) (int, error) {
+	v, err := optionalParam[float64](r, p)
+	if err != nil {
+		return 0, err
+	}
+	return int(v), nil
+}
+
+// optionalIntParamWithDefault is optionalIntParam but substitutes def
+// whenever the parameter is absent or zero.
+func optionalIntParamWithDefault(r mcp.CallToolRequest, p string, def int) (int, error) {
+	v, err := optionalIntParam(r, p)
+	if err != nil {
+		return 0, err
+	}
+	if v == 0 {
+		return def, nil
+	}
+	return v, nil
+}
+
+// optionalStringArrayParam extracts an optional array parameter as a
+// []string. It accepts both []string and []any (as produced by JSON
+// decoding), erroring if any element of the latter is not a string.
+func optionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error) {
+	args := r.GetArguments()
+
+	if _, ok := args[p]; !ok {
+		return []string{}, nil
+	}
+
+	switch v := args[p].(type) {
+	case []string:
+		return v, nil
+	case []any:
+		strs := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return []string{}, fmt.Errorf("parameter %s contains a non-string element: %v", p, item)
+			}
+			strs = append(strs, s)
+		}
+		return strs, nil
+	default:
+		return []string{}, fmt.Errorf("parameter %s is not of type []string, is %T", p, args[p])
+	}
+}
+
+// optionalTimeParam extracts an optional time parameter, accepting either an
+// RFC3339 timestamp or a unix epoch (seconds), the latter as either a JSON
+// number or a numeric string. It returns the zero time.Time when the
+// parameter is absent.
+func optionalTimeParam(r mcp.CallToolRequest, p string) (time.Time, error) {
+	args := r.GetArguments()
+
+	raw, ok := args[p]
+	if !ok {
+		return time.Time{}, nil
+	}
+
+	switch v := raw.(type) {
+	case string:
+		if v == "" {
+			return time.Time{}, nil
+		}
+		if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.Unix(epoch, 0).UTC(), nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing time %q: %w", v, err)
+		}
+		return t, nil
+	case float64:
+		return time.Unix(int64(v), 0).UTC(), nil
+	default:
+		return time.Time{}, fmt.Errorf("parameter %s is not a valid time, is %T", p, raw)
+	}
+}
+
+// optionalPaginationParams extracts page/perPage, defaulting to page 1 and
+// 30 items per page to match the GitHub API's own defaults.
+func optionalPaginationParams(r mcp.CallToolRequest) (paginationParams, error) {
+	page, err := optionalIntParamWithDefault(r, "page", 1)
+	if err != nil {
+		return paginationParams{}, err
+	}
+	perPage, err := optionalIntParamWithDefault(r, "perPage", 30)
+	if err != nil {
+		return paginationParams{}, err
+	}
+	return paginationParams{
+		page:    page,
+		perPage: perPage,
+	}, nil
+}
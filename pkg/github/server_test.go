@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/github/github-mcp-server/pkg/github/githubtest"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
@@ -18,118 +19,66 @@ import (
 func Test_GetMe(t *testing.T) {
 	// Verify tool definition
 	mockClient := github.NewClient(nil)
-	tool, _ := getMe(mockClient, translations.NullTranslationHelper)
+	tool, _ := getMe(NewStaticClientProvider(mockClient), translations.NullTranslationHelper)
 
 	assert.Equal(t, "get_me", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "reason")
 	assert.Empty(t, tool.InputSchema.Required) // No required parameters
 
-	// Setup mock user response
-	mockUser := &github.User{
-		Login:     github.Ptr("testuser"),
-		Name:      github.Ptr("Test User"),
-		Email:     github.Ptr("test@example.com"),
-		Bio:       github.Ptr("GitHub user for testing"),
-		Company:   github.Ptr("Test Company"),
-		Location:  github.Ptr("Test Location"),
-		HTMLURL:   github.Ptr("https://github.com/testuser"),
-		CreatedAt: &github.Timestamp{Time: time.Now().Add(-365 * 24 * time.Hour)},
-		Type:      github.Ptr("User"),
-		Plan: &github.Plan{
-			Name: github.Ptr("pro"),
-		},
-	}
+	mockUser := githubtest.FixtureUser(func(u *github.User) {
+		u.Login = github.Ptr("testuser")
+		u.Name = github.Ptr("Test User")
+		u.Email = github.Ptr("test@example.com")
+		u.Bio = github.Ptr("GitHub user for testing")
+		u.Company = github.Ptr("Test Company")
+		u.Location = github.Ptr("Test Location")
+		u.HTMLURL = github.Ptr("https://github.com/testuser")
+		u.CreatedAt = &github.Timestamp{Time: time.Now().Add(-365 * 24 * time.Hour)}
+		u.Plan = &github.Plan{Name: github.Ptr("pro")}
+	})
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedUser   *github.User
-		expectedErrMsg string
+		name        string
+		requestArgs map[string]interface{}
 	}{
 		{
-			name: "successful get user",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetUser,
-					mockUser,
-				),
-			),
-			requestArgs:  map[string]interface{}{},
-			expectError:  false,
-			expectedUser: mockUser,
+			name:        "successful get user",
+			requestArgs: map[string]interface{}{},
 		},
 		{
 			name: "successful get user with reason",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetUser,
-					mockUser,
-				),
-			),
 			requestArgs: map[string]interface{}{
 				"reason": "Testing API",
 			},
-			expectError:  false,
-			expectedUser: mockUser,
-		},
-		{
-			name: "get user fails",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetUser,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnauthorized)
-						_, _ = w.Write([]byte(`{"message": "Unauthorized"}`))
-					}),
-				),
-			),
-			requestArgs:    map[string]interface{}{},
-			expectError:    true,
-			expectedErrMsg: "failed to get user",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
-			client := github.NewClient(tc.mockedClient)
-			_, handler := getMe(client, translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			server := githubtest.NewServer(t, githubtest.Route{Pattern: mock.GetUser, Response: mockUser})
+			client := github.NewClient(server.Client())
+			_, handler := getMe(NewStaticClientProvider(client), translations.NullTranslationHelper)
 
-			// Call handler
-			result, err := handler(context.Background(), request)
-
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				return
-			}
-
-			require.NoError(t, err)
-
-			// Parse result and get text content if no error
-			textContent := getTextResult(t, result)
-
-			// Unmarshal and verify the result
-			var returnedUser github.User
-			err = json.Unmarshal([]byte(textContent.Text), &returnedUser)
-			require.NoError(t, err)
-
-			// Verify user details
-			assert.Equal(t, *tc.expectedUser.Login, *returnedUser.Login)
-			assert.Equal(t, *tc.expectedUser.Name, *returnedUser.Name)
-			assert.Equal(t, *tc.expectedUser.Email, *returnedUser.Email)
-			assert.Equal(t, *tc.expectedUser.Bio, *returnedUser.Bio)
-			assert.Equal(t, *tc.expectedUser.HTMLURL, *returnedUser.HTMLURL)
-			assert.Equal(t, *tc.expectedUser.Type, *returnedUser.Type)
+			githubtest.RunToolTest(t, handler, tc.requestArgs, mockUser)
 		})
 	}
+
+	t.Run("get user fails", func(t *testing.T) {
+		server := githubtest.NewServer(t, githubtest.Route{
+			Pattern: mock.GetUser,
+			Handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"message": "Unauthorized"}`))
+			},
+		})
+		client := github.NewClient(server.Client())
+		_, handler := getMe(NewStaticClientProvider(client), translations.NullTranslationHelper)
+
+		_, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get user")
+	})
 }
 
 func Test_IsAcceptedError(t *testing.T) {
@@ -635,118 +584,135 @@ func TestOptionalPaginationParams(t *testing.T) {
 	}
 }
 
+func Test_OptionalTimeParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    time.Time
+		expectError string
+	}{
+		{
+			name:      "missing parameter",
+			params:    map[string]interface{}{},
+			paramName: "since",
+			expected:  time.Time{},
+		},
+		{
+			name:      "RFC3339 timestamp",
+			params:    map[string]interface{}{"since": "2021-01-01T00:00:00Z"},
+			paramName: "since",
+			expected:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "unix epoch as string",
+			params:    map[string]interface{}{"since": "1609459200"},
+			paramName: "since",
+			expected:  time.Unix(1609459200, 0).UTC(),
+		},
+		{
+			name:      "unix epoch as number",
+			params:    map[string]interface{}{"since": float64(1609459200)},
+			paramName: "since",
+			expected:  time.Unix(1609459200, 0).UTC(),
+		},
+		{
+			name:        "malformed timestamp",
+			params:      map[string]interface{}{"since": "2006-01-02TZ"},
+			paramName:   "since",
+			expectError: `parsing time "2006-01-02TZ"`,
+		},
+		{
+			name:        "wrong type parameter",
+			params:      map[string]interface{}{"since": true},
+			paramName:   "since",
+			expectError: "is not a valid time",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := optionalTimeParam(request, tc.paramName)
+
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Equal(result))
+		})
+	}
+}
+
 func Test_GetLatestVersion(t *testing.T) {
 	// Verify tool definition
 	mockClient := github.NewClient(nil)
-	tool, _ := getLatestVersion(mockClient, "v1.0.0", translations.NullTranslationHelper)
+	tool, _ := getLatestVersion(NewStaticClientProvider(mockClient), "v1.0.0", translations.NullTranslationHelper)
 
 	assert.Equal(t, "get_latest_version", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 
-	// Setup mock release response
-	mockRelease := &github.RepositoryRelease{
-		TagName:     github.Ptr("v1.1.0"),
-		Name:        github.Ptr("Release v1.1.0"),
-		HTMLURL:     github.Ptr("https://github.com/github/github-mcp-server/releases/tag/v1.1.0"),
-		PublishedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
-	}
+	mockRelease := githubtest.FixtureRelease(func(r *github.RepositoryRelease) {
+		r.TagName = github.Ptr("v1.1.0")
+		r.Name = github.Ptr("Release v1.1.0")
+		r.HTMLURL = github.Ptr("https://github.com/github/github-mcp-server/releases/tag/v1.1.0")
+		r.PublishedAt = &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)}
+	})
 
 	tests := []struct {
 		name           string
-		mockedClient   *http.Client
 		currentVersion string
-		expectError    bool
-		expectedResult map[string]interface{}
-		expectedErrMsg string
+		wantUpToDate   bool
 	}{
 		{
-			name: "successful get latest version - up to date",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposReleasesLatestByOwnerByRepo,
-					mockResponse(t, http.StatusOK, mockRelease),
-				),
-			),
+			name:           "successful get latest version - up to date",
 			currentVersion: "v1.1.0",
-			expectError:    false,
-			expectedResult: map[string]interface{}{
-				"current_version": "v1.1.0",
-				"latest_version":  "v1.1.0",
-				"up_to_date":      true,
-				"release_url":     "https://github.com/github/github-mcp-server/releases/tag/v1.1.0",
-				// We can't test exact published_at since it's dynamic
-			},
-		},
-		{
-			name: "successful get latest version - outdated",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposReleasesLatestByOwnerByRepo,
-					mockResponse(t, http.StatusOK, mockRelease),
-				),
-			),
-			currentVersion: "v1.0.0",
-			expectError:    false,
-			expectedResult: map[string]interface{}{
-				"current_version": "v1.0.0",
-				"latest_version":  "v1.1.0",
-				"up_to_date":      false,
-				"release_url":     "https://github.com/github/github-mcp-server/releases/tag/v1.1.0",
-				// We can't test exact published_at since it's dynamic
-			},
+			wantUpToDate:   true,
 		},
 		{
-			name: "API request fails",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposReleasesLatestByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
-					}),
-				),
-			),
+			name:           "successful get latest version - outdated",
 			currentVersion: "v1.0.0",
-			expectError:    true,
-			expectedErrMsg: "failed to get latest release",
+			wantUpToDate:   false,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
-			client := github.NewClient(tc.mockedClient)
-			_, handler := getLatestVersion(client, tc.currentVersion, translations.NullTranslationHelper)
-
-			// Create call request with empty parameters (none needed for this API)
-			request := createMCPRequest(map[string]interface{}{})
-
-			// Call handler
-			result, err := handler(context.Background(), request)
-
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				return
-			}
+			server := githubtest.NewServer(t, githubtest.Route{Pattern: mock.GetReposReleasesLatestByOwnerByRepo, Response: mockRelease})
+			client := github.NewClient(server.Client())
+			_, handler := getLatestVersion(NewStaticClientProvider(client), tc.currentVersion, translations.NullTranslationHelper)
 
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
 			require.NoError(t, err)
 
-			// Parse result and get text content
 			textContent := getTextResult(t, result)
-
-			// Unmarshal and verify the result
 			var resultMap map[string]interface{}
-			err = json.Unmarshal([]byte(textContent.Text), &resultMap)
-			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &resultMap))
 
-			// Verify expected fields
-			assert.Equal(t, tc.expectedResult["current_version"], resultMap["current_version"])
-			assert.Equal(t, tc.expectedResult["latest_version"], resultMap["latest_version"])
-			assert.Equal(t, tc.expectedResult["up_to_date"], resultMap["up_to_date"])
-			assert.Equal(t, tc.expectedResult["release_url"], resultMap["release_url"])
+			assert.Equal(t, tc.currentVersion, resultMap["current_version"])
+			assert.Equal(t, mockRelease.GetTagName(), resultMap["latest_version"])
+			assert.Equal(t, tc.wantUpToDate, resultMap["up_to_date"])
+			assert.Equal(t, mockRelease.GetHTMLURL(), resultMap["release_url"])
 			assert.NotEmpty(t, resultMap["published_at"])
 		})
 	}
+
+	t.Run("API request fails", func(t *testing.T) {
+		server := githubtest.NewServer(t, githubtest.Route{
+			Pattern: mock.GetReposReleasesLatestByOwnerByRepo,
+			Handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			},
+		})
+		client := github.NewClient(server.Client())
+		_, handler := getLatestVersion(NewStaticClientProvider(client), "v1.0.0", translations.NullTranslationHelper)
+
+		_, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get latest release")
+	})
 }
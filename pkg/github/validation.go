@@ -0,0 +1,239 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ValidationError describes a single argument that failed to satisfy a
+// tool's declared InputSchema.
+type ValidationError struct {
+	// Param is the top-level parameter name the error belongs to.
+	Param string
+	// Expected describes the constraint that was violated.
+	Expected string
+	// Got describes the value (or its type) that was supplied.
+	Got string
+	// Path is Param, extended with array indices and object keys for
+	// nested failures (e.g. "filters.type[1]").
+	Path string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("parameter %s: expected %s, got %s (at %s)", e.Param, e.Expected, e.Got, e.Path)
+}
+
+// schemaValidator validates request arguments against a tool's InputSchema.
+// It is built once, when the tool's handler is wrapped, and reused for every
+// call to that tool; only the set of required parameters is precomputed, and
+// each call still walks the schema fragments (compiling any "pattern") for
+// the arguments it carries.
+type schemaValidator struct {
+	properties map[string]interface{}
+	required   map[string]struct{}
+}
+
+// newSchemaValidator compiles schema into a reusable validator.
+func newSchemaValidator(schema mcp.ToolInputSchema) *schemaValidator {
+	required := make(map[string]struct{}, len(schema.Required))
+	for _, r := range schema.Required {
+		required[r] = struct{}{}
+	}
+	return &schemaValidator{
+		properties: schema.Properties,
+		required:   required,
+	}
+}
+
+// validate checks args against v's compiled schema, collecting every
+// ValidationError it finds rather than stopping at the first.
+func (v *schemaValidator) validate(args map[string]interface{}) []*ValidationError {
+	var errs []*ValidationError
+
+	for name := range v.required {
+		if _, ok := args[name]; !ok {
+			errs = append(errs, &ValidationError{Param: name, Expected: "present", Got: "missing", Path: name})
+		}
+	}
+
+	for name, value := range args {
+		propSchema, ok := v.properties[name].(map[string]interface{})
+		if !ok {
+			// Undeclared parameter: not this layer's concern.
+			continue
+		}
+		errs = append(errs, validateValue(name, name, value, propSchema)...)
+	}
+
+	return errs
+}
+
+// validateValue checks a single value against its JSON-schema fragment,
+// recursing into array items and object properties. param is the top-level
+// parameter name the error should be attributed to; path is the full
+// dotted/indexed location of value within that parameter.
+func validateValue(param, path string, value interface{}, schema map[string]interface{}) []*ValidationError {
+	if enum, ok := schema["enum"].([]interface{}); ok && !enumContains(enum, value) {
+		return []*ValidationError{{Param: param, Expected: fmt.Sprintf("one of %v", enum), Got: fmt.Sprintf("%v", value), Path: path}}
+	}
+
+	schemaType, _ := schema["type"].(string)
+
+	switch schemaType {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return []*ValidationError{{Param: param, Expected: "string", Got: fmt.Sprintf("%T", value), Path: path}}
+		}
+		var errs []*ValidationError
+		if minLen, ok := asInt(schema["minLength"]); ok && len(s) < minLen {
+			errs = append(errs, &ValidationError{Param: param, Expected: fmt.Sprintf("minLength %d", minLen), Got: fmt.Sprintf("length %d", len(s)), Path: path})
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(s) {
+				errs = append(errs, &ValidationError{Param: param, Expected: fmt.Sprintf("match pattern %s", pattern), Got: s, Path: path})
+			}
+		}
+		return errs
+
+	case "number", "integer":
+		n, ok := value.(float64)
+		if !ok {
+			return []*ValidationError{{Param: param, Expected: "number", Got: fmt.Sprintf("%T", value), Path: path}}
+		}
+		var errs []*ValidationError
+		if min, ok := asFloat(schema["minimum"]); ok && n < min {
+			errs = append(errs, &ValidationError{Param: param, Expected: fmt.Sprintf("minimum %v", min), Got: fmt.Sprintf("%v", n), Path: path})
+		}
+		if max, ok := asFloat(schema["maximum"]); ok && n > max {
+			errs = append(errs, &ValidationError{Param: param, Expected: fmt.Sprintf("maximum %v", max), Got: fmt.Sprintf("%v", n), Path: path})
+		}
+		return errs
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []*ValidationError{{Param: param, Expected: "boolean", Got: fmt.Sprintf("%T", value), Path: path}}
+		}
+
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return []*ValidationError{{Param: param, Expected: "array", Got: fmt.Sprintf("%T", value), Path: path}}
+		}
+		alternatives := itemSchemaAlternatives(schema["items"])
+		if len(alternatives) == 0 {
+			return nil
+		}
+		var errs []*ValidationError
+		for i, item := range items {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if !matchesAnyAlternative(param, itemPath, item, alternatives) {
+				errs = append(errs, &ValidationError{Param: param, Expected: "item matching the declared schema", Got: fmt.Sprintf("%v", item), Path: itemPath})
+			}
+		}
+		return errs
+
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return []*ValidationError{{Param: param, Expected: "object", Got: fmt.Sprintf("%T", value), Path: path}}
+		}
+		var errs []*ValidationError
+		nestedProps, _ := schema["properties"].(map[string]interface{})
+		nestedRequired, _ := schema["required"].([]string)
+		for _, req := range nestedRequired {
+			if _, ok := obj[req]; !ok {
+				errs = append(errs, &ValidationError{Param: param, Expected: "present", Got: "missing", Path: path + "." + req})
+			}
+		}
+		for k, v := range obj {
+			childSchema, ok := nestedProps[k].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			errs = append(errs, validateValue(param, path+"."+k, v, childSchema)...)
+		}
+		return errs
+	}
+
+	return nil
+}
+
+// itemSchemaAlternatives normalizes an "items" schema fragment into the set
+// of schemas an array element may satisfy: a bare object schema is a single
+// alternative, an array of schemas ([]interface{}) is a union of
+// alternatives (heterogeneous items), and anything else imposes no
+// constraint.
+func itemSchemaAlternatives(items interface{}) []map[string]interface{} {
+	switch v := items.(type) {
+	case map[string]interface{}:
+		return []map[string]interface{}{v}
+	case []interface{}:
+		alternatives := make([]map[string]interface{}, 0, len(v))
+		for _, alt := range v {
+			if m, ok := alt.(map[string]interface{}); ok {
+				alternatives = append(alternatives, m)
+			}
+		}
+		return alternatives
+	default:
+		return nil
+	}
+}
+
+func matchesAnyAlternative(param, path string, value interface{}, alternatives []map[string]interface{}) bool {
+	for _, alt := range alternatives {
+		if len(validateValue(param, path, value, alt)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func enumContains(enum []interface{}, value interface{}) bool {
+	for _, v := range enum {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func asFloat(v interface{}) (float64, bool) {
+	f, ok := v.(float64)
+	return f, ok
+}
+
+func asInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case float64:
+		return int(n), true
+	case int:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+// withValidation wraps handler so that every incoming request's arguments
+// are checked against tool's already-declared InputSchema before handler
+// runs. The schema is compiled exactly once, when the tool is registered.
+func withValidation(tool mcp.Tool, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	validator := newSchemaValidator(tool.InputSchema)
+
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if errs := validator.validate(request.GetArguments()); len(errs) > 0 {
+			wrapped := make([]error, len(errs))
+			for i, e := range errs {
+				wrapped[i] = e
+			}
+			return nil, errors.Join(wrapped...)
+		}
+		return handler(ctx, request)
+	}
+}
@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SchemaValidator(t *testing.T) {
+	schema := mcp.ToolInputSchema{
+		Required: []string{"scope"},
+		Properties: map[string]interface{}{
+			"scope": map[string]interface{}{
+				"type": "string",
+				"enum": []interface{}{"user", "repo", "org"},
+			},
+			"page": map[string]interface{}{
+				"type":    "number",
+				"minimum": float64(1),
+			},
+			"filters": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"type": map[string]interface{}{
+						"type":  "array",
+						"items": map[string]interface{}{"type": "string"},
+					},
+				},
+			},
+			"name": map[string]interface{}{
+				"type":      "string",
+				"minLength": float64(3),
+				"pattern":   "^[a-z]+$",
+			},
+			"limit": map[string]interface{}{
+				"type":    "number",
+				"maximum": float64(100),
+			},
+			"tags": map[string]interface{}{
+				"type": "array",
+				"items": []interface{}{
+					map[string]interface{}{"type": "string"},
+					map[string]interface{}{"type": "number"},
+				},
+			},
+		},
+	}
+	validator := newSchemaValidator(schema)
+
+	tests := []struct {
+		name      string
+		args      map[string]interface{}
+		wantPaths []string
+	}{
+		{
+			name:      "valid arguments",
+			args:      map[string]interface{}{"scope": "user", "page": float64(2)},
+			wantPaths: nil,
+		},
+		{
+			name:      "missing required parameter",
+			args:      map[string]interface{}{},
+			wantPaths: []string{"scope"},
+		},
+		{
+			name:      "enum violation",
+			args:      map[string]interface{}{"scope": "nonsense"},
+			wantPaths: []string{"scope"},
+		},
+		{
+			name:      "minimum violation",
+			args:      map[string]interface{}{"scope": "user", "page": float64(0)},
+			wantPaths: []string{"page"},
+		},
+		{
+			name: "nested object and array violation",
+			args: map[string]interface{}{
+				"scope": "user",
+				"filters": map[string]interface{}{
+					"type": []interface{}{"PushEvent", 5},
+				},
+			},
+			wantPaths: []string{"filters.type[1]"},
+		},
+		{
+			name:      "minLength violation",
+			args:      map[string]interface{}{"scope": "user", "name": "ab"},
+			wantPaths: []string{"name"},
+		},
+		{
+			name:      "pattern violation",
+			args:      map[string]interface{}{"scope": "user", "name": "ABC"},
+			wantPaths: []string{"name"},
+		},
+		{
+			name:      "maximum violation",
+			args:      map[string]interface{}{"scope": "user", "limit": float64(150)},
+			wantPaths: []string{"limit"},
+		},
+		{
+			name: "heterogeneous array items accepts either alternative",
+			args: map[string]interface{}{
+				"scope": "user",
+				"tags":  []interface{}{"release", float64(2)},
+			},
+			wantPaths: nil,
+		},
+		{
+			name: "heterogeneous array items violation",
+			args: map[string]interface{}{
+				"scope": "user",
+				"tags":  []interface{}{"release", true},
+			},
+			wantPaths: []string{"tags[1]"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validator.validate(tc.args)
+
+			if tc.wantPaths == nil {
+				assert.Empty(t, errs)
+				return
+			}
+
+			var gotPaths []string
+			for _, e := range errs {
+				gotPaths = append(gotPaths, e.Path)
+			}
+			assert.Equal(t, tc.wantPaths, gotPaths)
+		})
+	}
+}
+
+func Test_WithValidation_RoundTrip(t *testing.T) {
+	// A tool's declared schema should reject exactly the inputs its own
+	// handler would otherwise have had to reject by hand. streamEvents
+	// already wraps its handler with withValidation internally, so this
+	// exercises that wiring rather than applying it a second time.
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetUsersEventsByUsername, []*github.Event{}),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := streamEvents(NewStaticClientProvider(client), translations.NullTranslationHelper)
+
+	_, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "scope")
+
+	_, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"scope": "user",
+		"user":  "octocat",
+		"filters": map[string]interface{}{
+			"type": []interface{}{"PushEvent"},
+		},
+	}))
+	require.NoError(t, err)
+}
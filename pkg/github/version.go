@@ -0,0 +1,58 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// getLatestVersion creates a tool that reports whether the server's
+// currentVersion is the latest release published on GitHub. The tool
+// declares no "owner" or "org" parameter, so an IdentityClientProvider
+// resolves it to the acting user's client unless the caller passes one of
+// those arguments anyway.
+func getLatestVersion(clients ClientProvider, currentVersion string, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_latest_version",
+		mcp.WithDescription(t("TOOL_GET_LATEST_VERSION_DESCRIPTION", "Check whether this server is running the latest released version")),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := clients.ClientFor(ctx, "get_latest_version", request.GetArguments())
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve client: %w", err)
+		}
+
+		release, resp, err := client.Repositories.GetLatestRelease(ctx, "github", "github-mcp-server")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest release: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != 200 {
+			body, _ := io.ReadAll(resp.Body)
+			return nil, fmt.Errorf("failed to get latest release: %s", string(body))
+		}
+
+		result := map[string]interface{}{
+			"current_version": currentVersion,
+			"latest_version":  release.GetTagName(),
+			"up_to_date":      release.GetTagName() == currentVersion,
+			"release_url":     release.GetHTMLURL(),
+			"published_at":    release.GetPublishedAt().String(),
+		}
+
+		r, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal result: %w", err)
+		}
+
+		return mcp.NewToolResultText(string(r)), nil
+	}
+
+	return tool, withValidation(tool, handler)
+}
@@ -0,0 +1,15 @@
+// Package translations provides a small indirection layer so that tool
+// descriptions can be overridden by the user (e.g. via environment
+// variables or a config file) without touching the Go source.
+package translations
+
+// TranslationHelperFunc looks up a translation for key, falling back to
+// defaultValue when no override is configured.
+type TranslationHelperFunc func(key string, defaultValue string) string
+
+// NullTranslationHelper is a TranslationHelperFunc that never translates,
+// always returning defaultValue. It is used in tests and anywhere a caller
+// does not care about localisation.
+func NullTranslationHelper(_ string, defaultValue string) string {
+	return defaultValue
+}